@@ -0,0 +1,131 @@
+package apimanagement
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceApiManagementApiOperationTag() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApiManagementApiOperationTagCreate,
+		Read:   resourceApiManagementApiOperationTagRead,
+		Delete: resourceApiManagementApiOperationTagDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApiOperationTagID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"api_operation_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApiOperationID,
+			},
+
+			"tag_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TagID,
+			},
+		},
+	}
+}
+
+func resourceApiManagementApiOperationTagCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.TagClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	operationId, err := parse.ApiOperationID(d.Get("api_operation_id").(string))
+	if err != nil {
+		return err
+	}
+
+	tagId, err := parse.TagID(d.Get("tag_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetEntityStateByOperation(ctx, operationId.ResourceGroup, operationId.ServiceName, operationId.ApiName, operationId.Name, tagId.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("checking for presence of existing Tag %q assignment to Api Operation %q (Api %q / API Management Service %q / Resource Group %q): %s", tagId.Name, operationId.Name, operationId.ApiName, operationId.ServiceName, operationId.ResourceGroup, err)
+		}
+	} else {
+		id := parse.NewApiOperationTagID(operationId.SubscriptionId, operationId.ResourceGroup, operationId.ServiceName, operationId.ApiName, operationId.Name, tagId.Name)
+		return tf.ImportAsExistsError("azurerm_api_management_api_operation_tag", id.ID())
+	}
+
+	if _, err := client.AssignToOperation(ctx, operationId.ResourceGroup, operationId.ServiceName, operationId.ApiName, operationId.Name, tagId.Name); err != nil {
+		return fmt.Errorf("assigning Tag %q to Api Operation %q (Api %q / API Management Service %q / Resource Group %q): %+v", tagId.Name, operationId.Name, operationId.ApiName, operationId.ServiceName, operationId.ResourceGroup, err)
+	}
+
+	id := parse.NewApiOperationTagID(operationId.SubscriptionId, operationId.ResourceGroup, operationId.ServiceName, operationId.ApiName, operationId.Name, tagId.Name)
+	d.SetId(id.ID())
+
+	return resourceApiManagementApiOperationTagRead(d, meta)
+}
+
+func resourceApiManagementApiOperationTagRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.TagClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApiOperationTagID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetEntityStateByOperation(ctx, id.ResourceGroup, id.ServiceName, id.ApiName, id.OperationName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("Tag %q assignment to Api Operation %q was not found in Api %q / API Management Service %q / Resource Group %q - removing from state!", id.Name, id.OperationName, id.ApiName, id.ServiceName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("making Read request on Tag %q assignment to Api Operation %q (Api %q / API Management Service %q / Resource Group %q): %+v", id.Name, id.OperationName, id.ApiName, id.ServiceName, id.ResourceGroup, err)
+	}
+
+	operationId := parse.NewApiOperationID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.OperationName)
+	tagId := parse.NewTagID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+
+	d.Set("api_operation_id", operationId.ID())
+	d.Set("tag_id", tagId.ID())
+
+	return nil
+}
+
+func resourceApiManagementApiOperationTagDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.TagClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApiOperationTagID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.DetachFromOperation(ctx, id.ResourceGroup, id.ServiceName, id.ApiName, id.OperationName, id.Name); err != nil {
+		return fmt.Errorf("detaching Tag %q from Api Operation %q (Api %q / API Management Service %q / Resource Group %q): %+v", id.Name, id.OperationName, id.ApiName, id.ServiceName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
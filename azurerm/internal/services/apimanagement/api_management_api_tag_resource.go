@@ -0,0 +1,131 @@
+package apimanagement
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceApiManagementApiTag() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApiManagementApiTagCreate,
+		Read:   resourceApiManagementApiTagRead,
+		Delete: resourceApiManagementApiTagDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ApiTagID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"api_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ApiID,
+			},
+
+			"tag_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TagID,
+			},
+		},
+	}
+}
+
+func resourceApiManagementApiTagCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.TagClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	apiId, err := parse.ApiID(d.Get("api_id").(string))
+	if err != nil {
+		return err
+	}
+
+	tagId, err := parse.TagID(d.Get("tag_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetEntityStateByApi(ctx, apiId.ResourceGroup, apiId.ServiceName, apiId.Name, tagId.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("checking for presence of existing Tag %q assignment to Api %q (API Management Service %q / Resource Group %q): %s", tagId.Name, apiId.Name, apiId.ServiceName, apiId.ResourceGroup, err)
+		}
+	} else {
+		id := parse.NewApiTagID(apiId.SubscriptionId, apiId.ResourceGroup, apiId.ServiceName, apiId.Name, tagId.Name)
+		return tf.ImportAsExistsError("azurerm_api_management_api_tag", id.ID())
+	}
+
+	if _, err := client.AssignToApi(ctx, apiId.ResourceGroup, apiId.ServiceName, apiId.Name, tagId.Name); err != nil {
+		return fmt.Errorf("assigning Tag %q to Api %q (API Management Service %q / Resource Group %q): %+v", tagId.Name, apiId.Name, apiId.ServiceName, apiId.ResourceGroup, err)
+	}
+
+	id := parse.NewApiTagID(apiId.SubscriptionId, apiId.ResourceGroup, apiId.ServiceName, apiId.Name, tagId.Name)
+	d.SetId(id.ID())
+
+	return resourceApiManagementApiTagRead(d, meta)
+}
+
+func resourceApiManagementApiTagRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.TagClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApiTagID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetEntityStateByApi(ctx, id.ResourceGroup, id.ServiceName, id.ApiName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("Tag %q assignment to Api %q was not found in API Management Service %q / Resource Group %q - removing from state!", id.Name, id.ApiName, id.ServiceName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("making Read request on Tag %q assignment to Api %q (API Management Service %q / Resource Group %q): %+v", id.Name, id.ApiName, id.ServiceName, id.ResourceGroup, err)
+	}
+
+	apiId := parse.NewApiID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName)
+	tagId := parse.NewTagID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+
+	d.Set("api_id", apiId.ID())
+	d.Set("tag_id", tagId.ID())
+
+	return nil
+}
+
+func resourceApiManagementApiTagDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.TagClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ApiTagID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.DetachFromApi(ctx, id.ResourceGroup, id.ServiceName, id.ApiName, id.Name); err != nil {
+		return fmt.Errorf("detaching Tag %q from Api %q (API Management Service %q / Resource Group %q): %+v", id.Name, id.ApiName, id.ServiceName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,131 @@
+package apimanagement
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/tf"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement/validate"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/timeouts"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+func resourceApiManagementProductTag() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceApiManagementProductTagCreate,
+		Read:   resourceApiManagementProductTagRead,
+		Delete: resourceApiManagementProductTagDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.ProductTagID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"product_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ProductID,
+			},
+
+			"tag_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.TagID,
+			},
+		},
+	}
+}
+
+func resourceApiManagementProductTagCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.TagClient
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	productId, err := parse.ProductID(d.Get("product_id").(string))
+	if err != nil {
+		return err
+	}
+
+	tagId, err := parse.TagID(d.Get("tag_id").(string))
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetEntityStateByProduct(ctx, productId.ResourceGroup, productId.ServiceName, productId.Name, tagId.Name)
+	if err != nil {
+		if !utils.ResponseWasNotFound(resp) {
+			return fmt.Errorf("checking for presence of existing Tag %q assignment to Product %q (API Management Service %q / Resource Group %q): %s", tagId.Name, productId.Name, productId.ServiceName, productId.ResourceGroup, err)
+		}
+	} else {
+		id := parse.NewProductTagID(productId.SubscriptionId, productId.ResourceGroup, productId.ServiceName, productId.Name, tagId.Name)
+		return tf.ImportAsExistsError("azurerm_api_management_product_tag", id.ID())
+	}
+
+	if _, err := client.AssignToProduct(ctx, productId.ResourceGroup, productId.ServiceName, productId.Name, tagId.Name); err != nil {
+		return fmt.Errorf("assigning Tag %q to Product %q (API Management Service %q / Resource Group %q): %+v", tagId.Name, productId.Name, productId.ServiceName, productId.ResourceGroup, err)
+	}
+
+	id := parse.NewProductTagID(productId.SubscriptionId, productId.ResourceGroup, productId.ServiceName, productId.Name, tagId.Name)
+	d.SetId(id.ID())
+
+	return resourceApiManagementProductTagRead(d, meta)
+}
+
+func resourceApiManagementProductTagRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.TagClient
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProductTagID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.GetEntityStateByProduct(ctx, id.ResourceGroup, id.ServiceName, id.ProductName, id.Name)
+	if err != nil {
+		if utils.ResponseWasNotFound(resp) {
+			log.Printf("Tag %q assignment to Product %q was not found in API Management Service %q / Resource Group %q - removing from state!", id.Name, id.ProductName, id.ServiceName, id.ResourceGroup)
+			d.SetId("")
+			return nil
+		}
+
+		return fmt.Errorf("making Read request on Tag %q assignment to Product %q (API Management Service %q / Resource Group %q): %+v", id.Name, id.ProductName, id.ServiceName, id.ResourceGroup, err)
+	}
+
+	productId := parse.NewProductID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ProductName)
+	tagId := parse.NewTagID(id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
+
+	d.Set("product_id", productId.ID())
+	d.Set("tag_id", tagId.ID())
+
+	return nil
+}
+
+func resourceApiManagementProductTagDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).ApiManagement.TagClient
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.ProductTagID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if _, err := client.DetachFromProduct(ctx, id.ResourceGroup, id.ServiceName, id.ProductName, id.Name); err != nil {
+		return fmt.Errorf("detaching Tag %q from Product %q (API Management Service %q / Resource Group %q): %+v", id.Name, id.ProductName, id.ServiceName, id.ResourceGroup, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package apimanagement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement/parse"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
+)
+
+type ApiManagementProductTagResource struct {
+}
+
+func TestAccApiManagementProductTag_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_product_tag", "test")
+	r := ApiManagementProductTagResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccApiManagementProductTag_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_product_tag", "test")
+	r := ApiManagementProductTagResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (ApiManagementProductTagResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ProductTagID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.ApiManagement.TagClient.GetEntityStateByProduct(ctx, id.ResourceGroup, id.ServiceName, id.ProductName, id.Name)
+	if err != nil {
+		return nil, fmt.Errorf("reading ApiManagement Product Tag (%s): %+v", id, err)
+	}
+
+	return utils.Bool(!utils.ResponseWasNotFound(resp)), nil
+}
+
+func (ApiManagementProductTagResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_api_management" "test" {
+  name                = "acctestAM-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_name      = "pub1"
+  publisher_email     = "pub1@email.com"
+
+  sku_name = "Developer_1"
+}
+
+resource "azurerm_api_management_product" "test" {
+  product_id             = "test-product"
+  api_management_name    = azurerm_api_management.test.name
+  resource_group_name    = azurerm_resource_group.test.name
+  display_name           = "Test Product"
+  subscription_required  = false
+  approval_required      = false
+  published              = true
+}
+
+resource "azurerm_api_management_tag" "test" {
+  tag_id                = "test-tag"
+  api_management_name   = azurerm_api_management.test.name
+  resource_group_name   = azurerm_resource_group.test.name
+  display_name          = "Test Tag"
+}
+
+resource "azurerm_api_management_product_tag" "test" {
+  product_id = azurerm_api_management_product.test.id
+  tag_id     = azurerm_api_management_tag.test.id
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
+func (r ApiManagementProductTagResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_api_management_product_tag" "import" {
+  product_id = azurerm_api_management_product_tag.test.product_id
+  tag_id     = azurerm_api_management_product_tag.test.tag_id
+}
+`, r.basic(data))
+}
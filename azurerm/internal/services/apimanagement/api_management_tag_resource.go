@@ -23,8 +23,10 @@ func resourceApiManagementTag() *pluginsdk.Resource {
 		Read:   resourceApiManagementTagRead,
 		Update: resourceApiManagementTagCreateUpdate,
 		Delete: resourceApiManagementTagDelete,
-		// TODO: replace this with an importer which validates the ID during import
-		Importer: pluginsdk.DefaultImporter(),
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.TagID(id)
+			return err
+		}),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
@@ -91,7 +93,7 @@ func resourceApiManagementTagCreateUpdate(d *pluginsdk.ResourceData, meta interf
 	}
 	d.SetId(*resp.ID)
 
-	return resourceApiManagementAPIPolicyRead(d, meta)
+	return resourceApiManagementTagRead(d, meta)
 }
 
 func resourceApiManagementTagRead(d *pluginsdk.ResourceData, meta interface{}) error {
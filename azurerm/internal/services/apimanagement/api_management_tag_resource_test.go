@@ -5,10 +5,10 @@ import (
 	"fmt"
 	"testing"
 
-	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/acceptance/check"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/clients"
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/services/apimanagement/parse"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
 	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/utils"
 )
@@ -82,8 +82,36 @@ func TestAccApiManagementTag_update(t *testing.T) {
 	})
 }
 
+func TestAccApiManagementTag_updateAndRefresh(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_api_management_tag", "test")
+	r := ApiManagementTagResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue("Test Tag"),
+			),
+		},
+		{
+			Config: r.updated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue("Test Updated Tag"),
+			),
+		},
+		{
+			RefreshState: true,
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("display_name").HasValue("Test Updated Tag"),
+			),
+		},
+	})
+}
+
 func TestAccApiManagementTag_complete(t *testing.T) {
-	data := acceptance.BuildTestData(t, "azurerm_api_management_product", "test")
+	data := acceptance.BuildTestData(t, "azurerm_api_management_tag", "test")
 	r := ApiManagementTagResource{}
 
 	data.ResourceTest(t, r, []acceptance.TestStep{
@@ -100,15 +128,12 @@ func TestAccApiManagementTag_complete(t *testing.T) {
 }
 
 func (ApiManagementTagResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
-	id, err := azure.ParseAzureResourceID(state.ID)
+	id, err := parse.TagID(state.ID)
 	if err != nil {
 		return nil, err
 	}
-	resourceGroup := id.ResourceGroup
-	serviceName := id.Path["service"]
-	tagID := id.Path["tags"]
 
-	resp, err := clients.ApiManagement.TagClient.Get(ctx, resourceGroup, serviceName, tagID)
+	resp, err := clients.ApiManagement.TagClient.Get(ctx, id.ResourceGroup, id.ServiceName, id.Name)
 	if err != nil {
 		return nil, fmt.Errorf("reading ApiManagement Tag (%s): %+v", id, err)
 	}
@@ -151,10 +176,10 @@ func (r ApiManagementTagResource) requiresImport(data acceptance.TestData) strin
 %s
 
 resource "azurerm_api_management_tag" "import" {
-  tag_id	            = azurerm_api_management_product.test.tag_id
-  api_management_name   = azurerm_api_management_product.test.api_management_name
-  resource_group_name   = azurerm_api_management_product.test.resource_group_name
-  display_name          = azurerm_api_management_product.test.display_name
+  tag_id	            = azurerm_api_management_tag.test.tag_id
+  api_management_name   = azurerm_api_management_tag.test.api_management_name
+  resource_group_name   = azurerm_api_management_tag.test.resource_group_name
+  display_name          = azurerm_api_management_tag.test.display_name
 }
 `, r.basic(data))
 }
@@ -181,7 +206,7 @@ resource "azurerm_api_management" "test" {
 }
 
 resource "azurerm_api_management_tag" "test" {
-  product_id            = "test-tag"
+  tag_id                = "test-tag"
   api_management_name   = azurerm_api_management.test.name
   resource_group_name   = azurerm_resource_group.test.name
   display_name          = "Test Updated Tag"
@@ -0,0 +1,87 @@
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/helpers/azure"
+)
+
+type ApiOperationTagId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ServiceName    string
+	ApiName        string
+	OperationName  string
+	Name           string
+}
+
+func NewApiOperationTagID(subscriptionId, resourceGroup, serviceName, apiName, operationName, name string) ApiOperationTagId {
+	return ApiOperationTagId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
+		ApiName:        apiName,
+		OperationName:  operationName,
+		Name:           name,
+	}
+}
+
+func (id ApiOperationTagId) String() string {
+	segments := []string{
+		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Operation Name %q", id.OperationName),
+		fmt.Sprintf("Api Name %q", id.ApiName),
+		fmt.Sprintf("Service Name %q", id.ServiceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Api Operation Tag", segmentsStr)
+}
+
+func (id ApiOperationTagId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/apis/%s/operations/%s/tags/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.ApiName, id.OperationName, id.Name)
+}
+
+// ApiOperationTagID parses a ApiOperationTag ID into an ApiOperationTagId struct
+func ApiOperationTagID(input string) (*ApiOperationTagId, error) {
+	id, err := azure.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceId := ApiOperationTagId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, fmt.Errorf("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, err
+	}
+	if resourceId.ApiName, err = id.PopSegment("apis"); err != nil {
+		return nil, err
+	}
+	if resourceId.OperationName, err = id.PopSegment("operations"); err != nil {
+		return nil, err
+	}
+	if resourceId.Name, err = id.PopSegment("tags"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
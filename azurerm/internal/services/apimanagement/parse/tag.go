@@ -12,13 +12,15 @@ import (
 type TagId struct {
 	SubscriptionId string
 	ResourceGroup  string
+	ServiceName    string
 	Name           string
 }
 
-func NewTagID(subscriptionId, resourceGroup, name string) TagId {
+func NewTagID(subscriptionId, resourceGroup, serviceName, name string) TagId {
 	return TagId{
 		SubscriptionId: subscriptionId,
 		ResourceGroup:  resourceGroup,
+		ServiceName:    serviceName,
 		Name:           name,
 	}
 }
@@ -26,6 +28,7 @@ func NewTagID(subscriptionId, resourceGroup, name string) TagId {
 func (id TagId) String() string {
 	segments := []string{
 		fmt.Sprintf("Name %q", id.Name),
+		fmt.Sprintf("Service Name %q", id.ServiceName),
 		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
 	}
 	segmentsStr := strings.Join(segments, " / ")
@@ -33,8 +36,8 @@ func (id TagId) String() string {
 }
 
 func (id TagId) ID() string {
-	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.AnalysisServices/tags/%s"
-	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.Name)
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.ApiManagement/service/%s/tags/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ServiceName, id.Name)
 }
 
 // TagID parses a Tag ID into an TagId struct
@@ -57,6 +60,10 @@ func TagID(input string) (*TagId, error) {
 		return nil, fmt.Errorf("ID was missing the 'resourceGroups' element")
 	}
 
+	if resourceId.ServiceName, err = id.PopSegment("service"); err != nil {
+		return nil, err
+	}
+
 	if resourceId.Name, err = id.PopSegment("tags"); err != nil {
 		return nil, err
 	}
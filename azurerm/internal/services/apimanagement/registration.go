@@ -0,0 +1,36 @@
+package apimanagement
+
+import (
+	"github.com/terraform-providers/terraform-provider-azurerm/azurerm/internal/tf/pluginsdk"
+)
+
+type Registration struct{}
+
+// Name is the name of this Service
+func (r Registration) Name() string {
+	return "API Management"
+}
+
+// WebsiteCategories returns the categories associated with this Service
+func (r Registration) WebsiteCategories() []string {
+	return []string{
+		"API Management",
+	}
+}
+
+// SupportedDataSources returns the supported Data Sources supported by this Service
+func (r Registration) SupportedDataSources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurerm_api_management_tag": dataSourceApiManagementTag(),
+	}
+}
+
+// SupportedResources returns the supported Resources supported by this Service
+func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
+	return map[string]*pluginsdk.Resource{
+		"azurerm_api_management_tag":               resourceApiManagementTag(),
+		"azurerm_api_management_api_tag":           resourceApiManagementApiTag(),
+		"azurerm_api_management_api_operation_tag": resourceApiManagementApiOperationTag(),
+		"azurerm_api_management_product_tag":       resourceApiManagementProductTag(),
+	}
+}